@@ -0,0 +1,75 @@
+package configstore
+
+// Getter is implemented by both [Store] and [Overlay], so code that only
+// needs to read config values can accept either.
+type Getter interface {
+	Get(key string) (string, error)
+}
+
+// Overlay is a per-request, in-memory layer of overrides on top of a
+// read-only config [Store].
+//
+// It lets a handler stage request-scoped changes (feature flags, A/B
+// variants, experiments) without touching the underlying store. Reads
+// that aren't overridden fall through to the base store; deletes are
+// recorded as tombstones so they aren't masked by the base store's
+// value. An Overlay is not safe for concurrent use.
+type Overlay struct {
+	base      Getter
+	overrides map[string]*string
+}
+
+// NewOverlay returns an Overlay backed by base. base may be nil, in
+// which case Get only ever sees the overlay's own overrides.
+func NewOverlay(base *Store) *Overlay {
+	o := &Overlay{}
+	// Storing a nil *Store directly in the Getter-typed field would
+	// produce a non-nil interface value, breaking the o.base == nil
+	// check in Get.
+	if base != nil {
+		o.base = base
+	}
+	return o
+}
+
+// Set stages an override for key, shadowing any value in the base store
+// until the Overlay is [Overlay.Reset] or the key is [Overlay.Delete]d.
+func (o *Overlay) Set(key, value string) {
+	if o.overrides == nil {
+		o.overrides = make(map[string]*string)
+	}
+	o.overrides[key] = &value
+}
+
+// Delete stages a tombstone for key, so that subsequent calls to Get
+// return [ErrKeyNotFound] regardless of whether the base store has a
+// value for it.
+func (o *Overlay) Delete(key string) {
+	if o.overrides == nil {
+		o.overrides = make(map[string]*string)
+	}
+	o.overrides[key] = nil
+}
+
+// Get returns the staged override for key, if any, honoring tombstones
+// recorded by [Overlay.Delete]. Otherwise it falls through to the base
+// store.
+func (o *Overlay) Get(key string) (string, error) {
+	if v, ok := o.overrides[key]; ok {
+		if v == nil {
+			return "", ErrKeyNotFound
+		}
+		return *v, nil
+	}
+
+	if o.base == nil {
+		return "", ErrKeyNotFound
+	}
+	return o.base.Get(key)
+}
+
+// Reset discards every staged override and tombstone, returning the
+// Overlay to passing reads straight through to the base store.
+func (o *Overlay) Reset() {
+	o.overrides = nil
+}
@@ -0,0 +1,39 @@
+package configstore
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestOverlayNilBase(t *testing.T) {
+	overlay := NewOverlay(nil)
+
+	if _, err := overlay.Get("greeting"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Get with nil base: got %v, want %v", err, ErrKeyNotFound)
+	}
+
+	overlay.Set("greeting", "hi")
+	if got, err := overlay.Get("greeting"); err != nil || got != "hi" {
+		t.Errorf("Get with nil base: got (%q, %v), want (%q, nil)", got, err, "hi")
+	}
+}
+
+func TestOverlayDeleteTombstone(t *testing.T) {
+	overlay := NewOverlay(nil)
+
+	overlay.Set("greeting", "hi")
+	overlay.Delete("greeting")
+	if _, err := overlay.Get("greeting"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Get after Delete: got %v, want %v", err, ErrKeyNotFound)
+	}
+}
+
+func TestOverlayReset(t *testing.T) {
+	overlay := NewOverlay(nil)
+
+	overlay.Set("greeting", "hi")
+	overlay.Reset()
+	if _, err := overlay.Get("greeting"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Get after Reset: got %v, want %v", err, ErrKeyNotFound)
+	}
+}
@@ -0,0 +1,36 @@
+//go:build ((tinygo.wasm && wasi) || wasip1) && !nofastlyhostcalls
+
+// Copyright 2024 Fastly, Inc.
+
+package main
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fastly/compute-sdk-go/configstore"
+)
+
+func TestConfigStoreOverlay(t *testing.T) {
+	store, err := configstore.Open("example-test-config-store")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	overlay := configstore.NewOverlay(store)
+
+	overlay.Set("greeting", "hi")
+	if got, err := overlay.Get("greeting"); err != nil || got != "hi" {
+		t.Errorf("Get: got (%q, %v), want (%q, nil)", got, err, "hi")
+	}
+
+	overlay.Delete("greeting")
+	if _, err := overlay.Get("greeting"); !errors.Is(err, configstore.ErrKeyNotFound) {
+		t.Errorf("Get after Delete: got %v, want %v", err, configstore.ErrKeyNotFound)
+	}
+
+	overlay.Reset()
+	if _, err := overlay.Get("greeting"); !errors.Is(err, configstore.ErrKeyNotFound) {
+		t.Errorf("Get after Reset: got %v, want %v", err, configstore.ErrKeyNotFound)
+	}
+}
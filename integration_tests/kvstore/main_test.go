@@ -5,6 +5,9 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"io"
 	"strings"
 	"testing"
 
@@ -40,3 +43,109 @@ func TestKVStore(t *testing.T) {
 		t.Errorf("Insert: got %q, want %q", got, want)
 	}
 }
+
+func TestKVStoreList(t *testing.T) {
+	store, err := kvstore.Open("example-test-kv-store")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := store.Keys("")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]bool{"hello": true, "animal": true}
+	got := map[string]bool{}
+	for _, k := range keys {
+		got[k] = true
+	}
+
+	for k := range want {
+		if !got[k] {
+			t.Errorf("Keys: missing expected key %q", k)
+		}
+	}
+}
+
+func TestKVStoreInsertOptions(t *testing.T) {
+	store, err := kvstore.Open("example-test-kv-store")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ModeAdd fails with ErrKeyExists for a key that's already there.
+	if err := store.Insert("counter", strings.NewReader("1")); err != nil {
+		t.Fatal(err)
+	}
+	err = store.Insert("counter", strings.NewReader("2"), kvstore.Mode(kvstore.ModeAdd))
+	if !errors.Is(err, kvstore.ErrKeyExists) {
+		t.Errorf("Insert with ModeAdd on existing key: got %v, want %v", err, kvstore.ErrKeyExists)
+	}
+
+	// Metadata and Generation round-trip through Insert and Lookup.
+	meta := []byte("v1")
+	if err := store.Insert("profile", strings.NewReader("alice"), kvstore.Metadata(meta)); err != nil {
+		t.Fatal(err)
+	}
+	entry, err := store.Lookup("profile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(entry.Metadata()), string(meta); got != want {
+		t.Errorf("Metadata: got %q, want %q", got, want)
+	}
+
+	// IfGenerationMatch fails with ErrPreconditionFailed when the
+	// generation doesn't match the key's current one.
+	err = store.Insert("profile", strings.NewReader("bob"), kvstore.IfGenerationMatch(entry.Generation()+1))
+	if !errors.Is(err, kvstore.ErrPreconditionFailed) {
+		t.Errorf("Insert with stale IfGenerationMatch: got %v, want %v", err, kvstore.ErrPreconditionFailed)
+	}
+
+	if err := store.Insert("profile", strings.NewReader("bob"), kvstore.IfGenerationMatch(entry.Generation())); err != nil {
+		t.Errorf("Insert with current IfGenerationMatch: %v", err)
+	}
+}
+
+func TestKVStoreBatch(t *testing.T) {
+	store, err := kvstore.Open("example-test-kv-store")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	insertErrs := store.InsertMulti(map[string]io.Reader{
+		"batch-1": strings.NewReader("one"),
+		"batch-2": strings.NewReader("two"),
+	})
+	for key, err := range insertErrs {
+		t.Errorf("InsertMulti: key %q: %v", key, err)
+	}
+
+	entries, lookupErrs := store.LookupMulti([]string{"batch-1", "batch-2", "batch-missing"})
+	for key, err := range lookupErrs {
+		if key != "batch-missing" {
+			t.Errorf("LookupMulti: key %q: %v", key, err)
+		}
+	}
+
+	if got, want := entries["batch-1"].String(), "one"; got != want {
+		t.Errorf("LookupMulti: batch-1: got %q, want %q", got, want)
+	}
+	if got, want := entries["batch-2"].String(), "two"; got != want {
+		t.Errorf("LookupMulti: batch-2: got %q, want %q", got, want)
+	}
+
+	res := store.Batch().
+		Insert("batch-3", strings.NewReader("three")).
+		Lookup("batch-1").
+		Delete("batch-2").
+		Do(context.Background())
+
+	if len(res.Errors) != 0 {
+		t.Errorf("Batch.Do: unexpected errors: %v", res.Errors)
+	}
+	if got, want := res.Entries["batch-1"].String(), "one"; got != want {
+		t.Errorf("Batch.Do: batch-1: got %q, want %q", got, want)
+	}
+}
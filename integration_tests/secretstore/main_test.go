@@ -0,0 +1,42 @@
+//go:build ((tinygo.wasm && wasi) || wasip1) && !nofastlyhostcalls
+
+// Copyright 2024 Fastly, Inc.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/fastly/compute-sdk-go/secretstore"
+)
+
+func TestSecretStore(t *testing.T) {
+	store, err := secretstore.Open("example-test-secret-store")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	secret, err := store.Get("hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plaintext, err := secret.Plaintext()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := string(plaintext), "world"; got != want {
+		t.Errorf("Plaintext: got %q, want %q", got, want)
+	}
+
+	// Plaintext can be read more than once.
+	plaintext, err = secret.Plaintext()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := string(plaintext), "world"; got != want {
+		t.Errorf("Plaintext (second read): got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,6 @@
+package fastly
+
+// maxGrowBufSize caps how large a read buffer is allowed to grow while
+// retrying a truncated hostcall read, so a pathological value can't
+// make a lookup allocate without bound.
+const maxGrowBufSize = 8 << 20 // 8 MiB
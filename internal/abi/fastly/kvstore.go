@@ -0,0 +1,256 @@
+//go:build ((tinygo.wasm && wasi) || wasip1) && !nofastlyhostcalls
+
+package fastly
+
+import (
+	"bytes"
+	"io"
+	"time"
+	"unsafe"
+)
+
+//go:wasmimport fastly_kv_store open
+func fastlyKVStoreOpen(namePtr *byte, nameLen uint32, handleOut *uint32) FastlyStatus
+
+//go:wasmimport fastly_kv_store lookup
+func fastlyKVStoreLookup(storeHandle uint32, keyPtr *byte, keyLen uint32, bufPtr *byte, bufLen uint32, nwrittenOut *uint32, metadataPtr *byte, metadataLen uint32, metadataWrittenOut *uint32, generationOut *uint64) FastlyStatus
+
+//go:wasmimport fastly_kv_store insert
+func fastlyKVStoreInsert(storeHandle uint32, keyPtr *byte, keyLen uint32, bodyPtr *byte, bodyLen uint32, mode uint32, metadataPtr *byte, metadataLen uint32, ttlSeconds uint32, ifGenerationMatch uint64, generationMatchSet uint32) FastlyStatus
+
+//go:wasmimport fastly_kv_store delete
+func fastlyKVStoreDelete(storeHandle uint32, keyPtr *byte, keyLen uint32) FastlyStatus
+
+//go:wasmimport fastly_kv_store list
+func fastlyKVStoreList(storeHandle uint32, prefixPtr *byte, prefixLen uint32, cursorPtr *byte, cursorLen uint32, limit uint32, bufPtr *byte, bufLen uint32, nwrittenOut *uint32, nextCursorPtr *byte, nextCursorLen uint32, nextCursorWrittenOut *uint32) FastlyStatus
+
+// lookupBufSize is the initial buffer size used to read a KV store
+// value.
+const lookupBufSize = 4096
+
+// metadataBufSize is the buffer size used to read a value's metadata.
+const metadataBufSize = 1024
+
+// listBufSize is the buffer size used to read a page of keys from a KV
+// store listing.
+const listBufSize = 4096
+
+// cursorBufSize is the buffer size used to read the next page cursor
+// from a KV store listing.
+const cursorBufSize = 256
+
+// KVStore is an opaque handle to an open Fastly KV store.
+type KVStore struct {
+	handle uint32
+}
+
+// OpenKVStore opens the named KV store.
+func OpenKVStore(name string) (*KVStore, error) {
+	var h uint32
+	status := fastlyKVStoreOpen(unsafe.StringData(name), uint32(len(name)), &h)
+	if err := newFastlyError(status); err != nil {
+		return nil, err
+	}
+	return &KVStore{handle: h}, nil
+}
+
+// KVStoreLookupResult holds the value and associated data returned by
+// [KVStore.Lookup].
+type KVStoreLookupResult struct {
+	// Body holds the looked-up value's contents.
+	Body io.Reader
+
+	// Generation is the value's current generation, for use with
+	// KVStoreInsertConfig.IfGenerationMatch.
+	Generation uint64
+
+	// Metadata is the opaque metadata attached to the value by
+	// KVStoreInsertConfig.Metadata, or nil if none was set.
+	Metadata []byte
+}
+
+// Lookup fetches the value stored under key.
+//
+// The body and metadata buffers are grown and the hostcall retried
+// whenever a read fills its buffer exactly, since that's the signal
+// that the value didn't fit and was truncated.
+func (k *KVStore) Lookup(key string) (*KVStoreLookupResult, error) {
+	bodySize := lookupBufSize
+	metadataSize := metadataBufSize
+
+	for {
+		buf := make([]byte, bodySize)
+		metadataBuf := make([]byte, metadataSize)
+		var n, metadataLen uint32
+		var generation uint64
+
+		status := fastlyKVStoreLookup(
+			k.handle,
+			unsafe.StringData(key), uint32(len(key)),
+			&buf[0], uint32(len(buf)), &n,
+			&metadataBuf[0], uint32(len(metadataBuf)), &metadataLen,
+			&generation,
+		)
+		if err := newFastlyError(status); err != nil {
+			return nil, err
+		}
+
+		bodyTruncated := int(n) == bodySize && bodySize < maxGrowBufSize
+		metadataTruncated := int(metadataLen) == metadataSize && metadataSize < maxGrowBufSize
+		if bodyTruncated || metadataTruncated {
+			if bodyTruncated {
+				bodySize *= 2
+			}
+			if metadataTruncated {
+				metadataSize *= 2
+			}
+			continue
+		}
+
+		var metadata []byte
+		if metadataLen > 0 {
+			metadata = metadataBuf[:metadataLen]
+		}
+
+		return &KVStoreLookupResult{
+			Body:       bytes.NewReader(buf[:n]),
+			Generation: generation,
+			Metadata:   metadata,
+		}, nil
+	}
+}
+
+// KVStoreInsertMode selects how [KVStore.Insert] treats an existing
+// value for the same key.
+type KVStoreInsertMode uint32
+
+const (
+	KVStoreInsertModeOverwrite KVStoreInsertMode = iota
+	KVStoreInsertModeAdd
+	KVStoreInsertModeAppend
+	KVStoreInsertModePrepend
+)
+
+// KVStoreInsertConfig configures a call to [KVStore.Insert].
+type KVStoreInsertConfig struct {
+	Mode               KVStoreInsertMode
+	Metadata           []byte
+	TimeToLive         time.Duration
+	IfGenerationMatch  uint64
+	GenerationMatchSet bool
+}
+
+// Insert writes value under key according to cfg.
+func (k *KVStore) Insert(key string, value io.Reader, cfg *KVStoreInsertConfig) error {
+	body, err := io.ReadAll(value)
+	if err != nil {
+		return err
+	}
+	if cfg == nil {
+		cfg = &KVStoreInsertConfig{}
+	}
+
+	var bodyPtr *byte
+	if len(body) > 0 {
+		bodyPtr = &body[0]
+	}
+	var metadataPtr *byte
+	if len(cfg.Metadata) > 0 {
+		metadataPtr = &cfg.Metadata[0]
+	}
+	var generationMatchSet uint32
+	if cfg.GenerationMatchSet {
+		generationMatchSet = 1
+	}
+
+	status := fastlyKVStoreInsert(
+		k.handle,
+		unsafe.StringData(key), uint32(len(key)),
+		bodyPtr, uint32(len(body)),
+		uint32(cfg.Mode),
+		metadataPtr, uint32(len(cfg.Metadata)),
+		uint32(cfg.TimeToLive.Seconds()),
+		cfg.IfGenerationMatch,
+		generationMatchSet,
+	)
+	return newFastlyError(status)
+}
+
+// Delete removes key.
+func (k *KVStore) Delete(key string) error {
+	status := fastlyKVStoreDelete(k.handle, unsafe.StringData(key), uint32(len(key)))
+	return newFastlyError(status)
+}
+
+// KVStoreListResult holds one page of a KV store key listing.
+type KVStoreListResult struct {
+	// Keys holds the keys returned in this page.
+	Keys []string
+
+	// NextCursor resumes the listing after this page, or is empty if
+	// this was the last page.
+	NextCursor string
+}
+
+// ListKeys returns one page of keys in the store matching prefix,
+// starting after cursor (or from the beginning, if cursor is empty).
+// limit caps the number of keys returned; zero uses the host's default.
+//
+// The key-page and cursor buffers are grown and the hostcall retried
+// whenever a read fills its buffer exactly, since that's the signal
+// that the page or cursor didn't fit and was truncated.
+func (k *KVStore) ListKeys(prefix, cursor string, limit int) (*KVStoreListResult, error) {
+	var prefixPtr, cursorPtr *byte
+	if len(prefix) > 0 {
+		prefixPtr = unsafe.StringData(prefix)
+	}
+	if len(cursor) > 0 {
+		cursorPtr = unsafe.StringData(cursor)
+	}
+
+	keysSize := listBufSize
+	nextCursorSize := cursorBufSize
+
+	for {
+		keysBuf := make([]byte, keysSize)
+		var keysLen uint32
+		nextCursorBuf := make([]byte, nextCursorSize)
+		var nextCursorLen uint32
+
+		status := fastlyKVStoreList(
+			k.handle,
+			prefixPtr, uint32(len(prefix)),
+			cursorPtr, uint32(len(cursor)),
+			uint32(limit),
+			&keysBuf[0], uint32(len(keysBuf)), &keysLen,
+			&nextCursorBuf[0], uint32(len(nextCursorBuf)), &nextCursorLen,
+		)
+		if err := newFastlyError(status); err != nil {
+			return nil, err
+		}
+
+		keysTruncated := int(keysLen) == keysSize && keysSize < maxGrowBufSize
+		cursorTruncated := int(nextCursorLen) == nextCursorSize && nextCursorSize < maxGrowBufSize
+		if keysTruncated || cursorTruncated {
+			if keysTruncated {
+				keysSize *= 2
+			}
+			if cursorTruncated {
+				nextCursorSize *= 2
+			}
+			continue
+		}
+
+		var keys []string
+		if keysLen > 0 {
+			for _, k := range bytes.Split(bytes.TrimRight(keysBuf[:keysLen], "\n"), []byte("\n")) {
+				keys = append(keys, string(k))
+			}
+		}
+
+		return &KVStoreListResult{
+			Keys:       keys,
+			NextCursor: string(nextCursorBuf[:nextCursorLen]),
+		}, nil
+	}
+}
@@ -0,0 +1,73 @@
+//go:build !(((tinygo.wasm && wasi) || wasip1) && !nofastlyhostcalls)
+
+package fastly
+
+import (
+	"io"
+	"time"
+)
+
+// KVStore is an opaque handle to an open Fastly KV store.
+//
+// Outside a Compute program, KV store hostcalls aren't available; every
+// method returns [FastlyStatusUnsupported].
+type KVStore struct{}
+
+// OpenKVStore opens the named KV store.
+func OpenKVStore(name string) (*KVStore, error) {
+	return nil, newFastlyError(FastlyStatusUnsupported)
+}
+
+// KVStoreLookupResult holds the value and associated data returned by
+// [KVStore.Lookup].
+type KVStoreLookupResult struct {
+	Body       io.Reader
+	Generation uint64
+	Metadata   []byte
+}
+
+// Lookup fetches the value stored under key.
+func (k *KVStore) Lookup(key string) (*KVStoreLookupResult, error) {
+	return nil, newFastlyError(FastlyStatusUnsupported)
+}
+
+// KVStoreInsertMode selects how [KVStore.Insert] treats an existing
+// value for the same key.
+type KVStoreInsertMode uint32
+
+const (
+	KVStoreInsertModeOverwrite KVStoreInsertMode = iota
+	KVStoreInsertModeAdd
+	KVStoreInsertModeAppend
+	KVStoreInsertModePrepend
+)
+
+// KVStoreInsertConfig configures a call to [KVStore.Insert].
+type KVStoreInsertConfig struct {
+	Mode               KVStoreInsertMode
+	Metadata           []byte
+	TimeToLive         time.Duration
+	IfGenerationMatch  uint64
+	GenerationMatchSet bool
+}
+
+// Insert writes value under key according to cfg.
+func (k *KVStore) Insert(key string, value io.Reader, cfg *KVStoreInsertConfig) error {
+	return newFastlyError(FastlyStatusUnsupported)
+}
+
+// Delete removes key.
+func (k *KVStore) Delete(key string) error {
+	return newFastlyError(FastlyStatusUnsupported)
+}
+
+// KVStoreListResult holds one page of a KV store key listing.
+type KVStoreListResult struct {
+	Keys       []string
+	NextCursor string
+}
+
+// ListKeys returns one page of keys in the store matching prefix.
+func (k *KVStore) ListKeys(prefix, cursor string, limit int) (*KVStoreListResult, error) {
+	return nil, newFastlyError(FastlyStatusUnsupported)
+}
@@ -0,0 +1,76 @@
+//go:build ((tinygo.wasm && wasi) || wasip1) && !nofastlyhostcalls
+
+package fastly
+
+import (
+	"bytes"
+	"io"
+	"unsafe"
+)
+
+//go:wasmimport fastly_secret_store open
+func fastlySecretStoreOpen(namePtr *byte, nameLen uint32, handleOut *uint32) FastlyStatus
+
+//go:wasmimport fastly_secret_store get
+func fastlySecretStoreGet(storeHandle uint32, keyPtr *byte, keyLen uint32, secretHandleOut *uint32) FastlyStatus
+
+//go:wasmimport fastly_secret_store plaintext_get
+func fastlySecretStorePlaintextGet(secretHandle uint32, bufPtr *byte, bufLen uint32, nwrittenOut *uint32) FastlyStatus
+
+// SecretStore is an opaque handle to an open Fastly secret store.
+type SecretStore struct {
+	handle uint32
+}
+
+// OpenSecretStore opens the named secret store.
+func OpenSecretStore(name string) (*SecretStore, error) {
+	var h uint32
+	status := fastlySecretStoreOpen(unsafe.StringData(name), uint32(len(name)), &h)
+	if err := newFastlyError(status); err != nil {
+		return nil, err
+	}
+	return &SecretStore{handle: h}, nil
+}
+
+// Get fetches the secret stored under key, without decrypting it.
+func (s *SecretStore) Get(key string) (*Secret, error) {
+	var h uint32
+	status := fastlySecretStoreGet(s.handle, unsafe.StringData(key), uint32(len(key)), &h)
+	if err := newFastlyError(status); err != nil {
+		return nil, err
+	}
+	return &Secret{handle: h}, nil
+}
+
+// Secret is an opaque handle to a value held in a Fastly secret store.
+type Secret struct {
+	handle uint32
+}
+
+// plaintextBufSize is the initial buffer size used to read a secret's
+// plaintext. It's large enough for typical credentials and tokens.
+const plaintextBufSize = 4096
+
+// Plaintext decrypts the secret and returns a reader over its contents.
+//
+// The buffer is grown and the hostcall retried whenever a read fills it
+// exactly, since that's the signal that the plaintext didn't fit and
+// was truncated, so values larger than plaintextBufSize are supported.
+func (s *Secret) Plaintext() (io.Reader, error) {
+	size := plaintextBufSize
+	for {
+		buf := make([]byte, size)
+		var n uint32
+		status := fastlySecretStorePlaintextGet(s.handle, &buf[0], uint32(len(buf)), &n)
+		if err := newFastlyError(status); err != nil {
+			return nil, err
+		}
+
+		if int(n) == size && size < maxGrowBufSize {
+			size *= 2
+			continue
+		}
+
+		return bytes.NewReader(buf[:n]), nil
+	}
+}
@@ -0,0 +1,29 @@
+//go:build !(((tinygo.wasm && wasi) || wasip1) && !nofastlyhostcalls)
+
+package fastly
+
+import "io"
+
+// SecretStore is an opaque handle to an open Fastly secret store.
+//
+// Outside a Compute program, secret store hostcalls aren't available;
+// every method returns [FastlyStatusUnsupported].
+type SecretStore struct{}
+
+// OpenSecretStore opens the named secret store.
+func OpenSecretStore(name string) (*SecretStore, error) {
+	return nil, newFastlyError(FastlyStatusUnsupported)
+}
+
+// Get fetches the secret stored under key, without decrypting it.
+func (s *SecretStore) Get(key string) (*Secret, error) {
+	return nil, newFastlyError(FastlyStatusUnsupported)
+}
+
+// Secret is an opaque handle to a value held in a Fastly secret store.
+type Secret struct{}
+
+// Plaintext decrypts the secret and returns a reader over its contents.
+func (s *Secret) Plaintext() (io.Reader, error) {
+	return nil, newFastlyError(FastlyStatusUnsupported)
+}
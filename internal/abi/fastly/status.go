@@ -0,0 +1,90 @@
+package fastly
+
+import (
+	"errors"
+	"fmt"
+)
+
+// FastlyStatus is a status code returned by a Fastly Compute hostcall.
+type FastlyStatus uint32
+
+const (
+	// FastlyStatusOK indicates success.
+	FastlyStatusOK FastlyStatus = iota
+
+	// FastlyStatusUnsupported indicates that the requested operation is
+	// not supported, e.g. because the hostcall isn't available on the
+	// current platform.
+	FastlyStatusUnsupported
+
+	// FastlyStatusBadf indicates that a handle passed to the hostcall
+	// was invalid.
+	FastlyStatusBadf
+
+	// FastlyStatusInval indicates that an argument passed to the
+	// hostcall was invalid.
+	FastlyStatusInval
+
+	// FastlyStatusNone indicates that the requested item (a store, key,
+	// secret, etc.) does not exist.
+	FastlyStatusNone
+
+	// FastlyStatusExist indicates that an item already exists where the
+	// caller asked for it not to.
+	FastlyStatusExist
+
+	// FastlyStatusPrecondFailed indicates that a caller-supplied
+	// precondition, such as a generation match, was not satisfied.
+	FastlyStatusPrecondFailed
+)
+
+func (s FastlyStatus) String() string {
+	switch s {
+	case FastlyStatusOK:
+		return "ok"
+	case FastlyStatusUnsupported:
+		return "unsupported"
+	case FastlyStatusBadf:
+		return "bad handle"
+	case FastlyStatusInval:
+		return "invalid argument"
+	case FastlyStatusNone:
+		return "none"
+	case FastlyStatusExist:
+		return "already exists"
+	case FastlyStatusPrecondFailed:
+		return "precondition failed"
+	default:
+		return fmt.Sprintf("status(%d)", uint32(s))
+	}
+}
+
+// fastlyError wraps a FastlyStatus returned by a hostcall so that it can
+// be recovered by callers via IsFastlyError, while still satisfying the
+// error interface.
+type fastlyError struct {
+	status FastlyStatus
+}
+
+func (e *fastlyError) Error() string {
+	return fmt.Sprintf("fastly: %s", e.status)
+}
+
+// newFastlyError wraps status as an error, or returns nil if status is
+// [FastlyStatusOK].
+func newFastlyError(status FastlyStatus) error {
+	if status == FastlyStatusOK {
+		return nil
+	}
+	return &fastlyError{status: status}
+}
+
+// IsFastlyError reports whether err (or one it wraps) originated from a
+// Fastly hostcall, returning its status code if so.
+func IsFastlyError(err error) (FastlyStatus, bool) {
+	var fe *fastlyError
+	if errors.As(err, &fe) {
+		return fe.status, true
+	}
+	return FastlyStatusOK, false
+}
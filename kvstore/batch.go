@@ -0,0 +1,156 @@
+package kvstore
+
+import (
+	"context"
+	"io"
+)
+
+// LookupMulti fetches keys from the associated KV store, one hostcall
+// per key.
+//
+// This is a convenience for issuing several lookups from one call site,
+// not a performance optimization: Compute programs run as a
+// single-threaded Wasm instance with synchronous hostcalls, so the
+// lookups still happen one at a time. It is not a server-side
+// transaction either: the two returned maps partition keys between
+// those that were found and those that weren't, so a failure looking up
+// one key (e.g. [ErrKeyNotFound]) doesn't affect the others.
+func (s *Store) LookupMulti(keys []string) (map[string]*Entry, map[string]error) {
+	entries := make(map[string]*Entry, len(keys))
+	errs := make(map[string]error)
+
+	for _, key := range keys {
+		entry, err := s.Lookup(key)
+		if err != nil {
+			errs[key] = err
+			continue
+		}
+		entries[key] = entry
+	}
+
+	return entries, errs
+}
+
+// InsertMulti inserts items into the associated KV store, one hostcall
+// per key, and returns any per-key errors.
+//
+// Like [Store.LookupMulti], this is a convenience API, not a
+// performance one: Compute's single-threaded Wasm runtime means the
+// inserts happen sequentially. It is also client-side fan-out rather
+// than a server-side transaction: a failure inserting one key doesn't
+// affect the others, and the returned map only contains entries for
+// keys that failed.
+func (s *Store) InsertMulti(items map[string]io.Reader) map[string]error {
+	errs := make(map[string]error)
+
+	for key, value := range items {
+		if err := s.Insert(key, value); err != nil {
+			errs[key] = err
+		}
+	}
+
+	return errs
+}
+
+type batchOpKind int
+
+const (
+	batchLookup batchOpKind = iota
+	batchInsert
+	batchDelete
+)
+
+type batchOp struct {
+	kind  batchOpKind
+	key   string
+	value io.Reader
+	opts  []InsertOption
+}
+
+// Batch collects a pipeline of Lookup, Insert, and Delete operations to
+// run against a Store from one call site.
+//
+// Like [Store.LookupMulti] and [Store.InsertMulti], Batch is a
+// convenience API: its operations run sequentially, not concurrently,
+// and each is its own hostcall rather than part of a server-side
+// transaction. Partial failure is expected: [Batch.Do] reports a
+// distinct error per failed key in [Results.Errors] while leaving the
+// rest of the batch's results intact.
+type Batch struct {
+	store *Store
+	ops   []batchOp
+}
+
+// Batch returns a new, empty [Batch] of operations to run against s.
+func (s *Store) Batch() *Batch {
+	return &Batch{store: s}
+}
+
+// Lookup stages a lookup of key.
+func (b *Batch) Lookup(key string) *Batch {
+	b.ops = append(b.ops, batchOp{kind: batchLookup, key: key})
+	return b
+}
+
+// Insert stages an insert of key with the given value and options; see
+// [Store.Insert].
+func (b *Batch) Insert(key string, value io.Reader, opts ...InsertOption) *Batch {
+	b.ops = append(b.ops, batchOp{kind: batchInsert, key: key, value: value, opts: opts})
+	return b
+}
+
+// Delete stages a delete of key.
+func (b *Batch) Delete(key string) *Batch {
+	b.ops = append(b.ops, batchOp{kind: batchDelete, key: key})
+	return b
+}
+
+// Results holds the per-key outcome of a [Batch.Do] call.
+type Results struct {
+	// Entries holds the result of every successful Lookup operation,
+	// keyed by its key.
+	Entries map[string]*Entry
+
+	// Errors holds the error for every operation that failed, keyed by
+	// its key.
+	Errors map[string]error
+}
+
+// Do runs every operation staged on the batch, in order, and collects
+// the results. Operations not yet reached when ctx is done are recorded
+// in Results.Errors with ctx's error instead of running.
+func (b *Batch) Do(ctx context.Context) Results {
+	res := Results{
+		Entries: make(map[string]*Entry),
+		Errors:  make(map[string]error),
+	}
+
+	for _, op := range b.ops {
+		if err := ctx.Err(); err != nil {
+			res.Errors[op.key] = err
+			continue
+		}
+
+		switch op.kind {
+		case batchLookup:
+			entry, err := b.store.Lookup(op.key)
+			if err != nil {
+				res.Errors[op.key] = err
+				continue
+			}
+			res.Entries[op.key] = entry
+
+		case batchInsert:
+			if err := b.store.Insert(op.key, op.value, op.opts...); err != nil {
+				res.Errors[op.key] = err
+			}
+
+		case batchDelete:
+			if err := b.store.Delete(op.key); err != nil {
+				res.Errors[op.key] = err
+			}
+		}
+	}
+
+	return res
+}
@@ -27,6 +27,15 @@ var (
 	// ErrInvalidKey indicates that the given key is invalid.
 	ErrInvalidKey = errors.New("kvstore: invalid key")
 
+	// ErrKeyExists indicates that an Insert with [ModeAdd] was rejected
+	// because the key already exists.
+	ErrKeyExists = errors.New("kvstore: key exists")
+
+	// ErrPreconditionFailed indicates that an Insert with
+	// [IfGenerationMatch] was rejected because the key's current
+	// generation did not match.
+	ErrPreconditionFailed = errors.New("kvstore: precondition failed")
+
 	// ErrUnexpected indicates than an unexpected error occurred.
 	ErrUnexpected = errors.New("kvstore: unexpected error")
 )
@@ -46,6 +55,22 @@ type Entry struct {
 
 	validString bool
 	s           string
+
+	generation uint64
+	metadata   []byte
+}
+
+// Generation returns the generation (a.k.a. version) of the value
+// returned by [Store.Lookup], for use with [IfGenerationMatch].
+func (e *Entry) Generation() uint64 {
+	return e.generation
+}
+
+// Metadata returns the metadata associated with the value returned by
+// [Store.Lookup], as set by the [Metadata] insert option. It is nil if
+// no metadata was set.
+func (e *Entry) Metadata() []byte {
+	return e.metadata
 }
 
 // String consumes the entire contents of the Entry and returns it as a
@@ -110,17 +135,30 @@ func (s *Store) Lookup(key string) (*Entry, error) {
 		}
 	}
 
-	return &Entry{Reader: val}, err
+	return &Entry{Reader: val.Body, generation: val.Generation, metadata: val.Metadata}, err
 }
 
 // Insert adds a key to the associated KV store.
-func (s *Store) Insert(key string, value io.Reader) error {
-	err := s.kvstore.Insert(key, value)
+//
+// By default, Insert creates the key if it doesn't exist and overwrites
+// it if it does. Pass [InsertOption] values such as [Mode],
+// [IfGenerationMatch], [TTL], and [Metadata] to change that behavior.
+func (s *Store) Insert(key string, value io.Reader, opts ...InsertOption) error {
+	var cfg insertConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	err := s.kvstore.Insert(key, value, cfg.abi())
 	if err != nil {
 		status, ok := fastly.IsFastlyError(err)
 		switch {
 		case ok && status == fastly.FastlyStatusInval:
 			return ErrInvalidKey
+		case ok && status == fastly.FastlyStatusExist:
+			return ErrKeyExists
+		case ok && status == fastly.FastlyStatusPrecondFailed:
+			return ErrPreconditionFailed
 		case ok:
 			return fmt.Errorf("%w (%s)", ErrUnexpected, status)
 		default:
@@ -0,0 +1,158 @@
+package kvstore
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/fastly/compute-sdk-go/internal/abi/fastly"
+)
+
+// ErrInvalidListOptions indicates that [ListOptions.Prefix] or
+// [ListOptions.Cursor] was rejected by the store, for example because
+// the cursor was issued by a different store or has expired. The host
+// doesn't distinguish which of the two was at fault, so this single
+// sentinel error covers both.
+var ErrInvalidListOptions = errors.New("kvstore: invalid prefix or cursor")
+
+// ListOptions configures a call to [Store.List].
+type ListOptions struct {
+	// Prefix restricts the listing to keys beginning with this string.
+	// An empty Prefix lists all keys in the store.
+	Prefix string
+
+	// Limit caps the number of keys returned per underlying hostcall.
+	// Zero uses the host's default page size.
+	Limit int
+
+	// Cursor resumes a listing previously interrupted by, e.g., the end
+	// of a request. It should be the value returned by [Iterator.Cursor]
+	// from an earlier listing with the same Prefix.
+	Cursor string
+}
+
+// Iterator iterates over the keys of a [Store], fetching additional
+// pages from the host as needed.
+//
+// Call [Iterator.Next] to advance the iterator, and [Iterator.Key] to
+// read the current key. Iteration stops at the end of the list or on
+// the first error, which is available from [Iterator.Err].
+type Iterator struct {
+	store  *Store
+	prefix string
+	limit  int
+
+	// cursor resumes the current (or, once exhausted, the next) page.
+	// It is only advanced to pending once the current page has been
+	// fully delivered via Next/Key, so that Cursor always resumes
+	// without skipping a key the caller hasn't seen yet.
+	cursor  string
+	pending string
+	fetched bool
+	done    bool
+
+	keys []string
+	i    int
+
+	err error
+}
+
+// List returns an [Iterator] over the keys in the associated KV store,
+// optionally restricted to those matching opts.Prefix. A nil opts lists
+// every key in the store.
+func (s *Store) List(opts *ListOptions) *Iterator {
+	it := &Iterator{store: s}
+	if opts != nil {
+		it.prefix = opts.Prefix
+		it.limit = opts.Limit
+		it.cursor = opts.Cursor
+	}
+	return it
+}
+
+// Next advances the iterator to the next key, fetching additional pages
+// from the host as needed. It returns false when iteration is complete
+// or an error occurred; callers should check [Iterator.Err] afterward.
+func (it *Iterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.i >= len(it.keys) {
+		if it.done {
+			return false
+		}
+		if it.fetched {
+			// The previous page has been fully delivered; only now is
+			// it safe to resume from its successor.
+			it.cursor = it.pending
+		}
+		if err := it.fetch(); err != nil {
+			it.err = err
+			return false
+		}
+		it.fetched = true
+	}
+
+	it.i++
+	return true
+}
+
+func (it *Iterator) fetch() error {
+	res, err := it.store.kvstore.ListKeys(it.prefix, it.cursor, it.limit)
+	if err != nil {
+		status, ok := fastly.IsFastlyError(err)
+		switch {
+		case ok && status == fastly.FastlyStatusInval:
+			return ErrInvalidListOptions
+		case ok:
+			return fmt.Errorf("%w (%s)", ErrUnexpected, status)
+		default:
+			return err
+		}
+	}
+
+	it.keys = res.Keys
+	it.i = 0
+	it.pending = res.NextCursor
+	it.done = it.pending == ""
+	return nil
+}
+
+// Key returns the key at the iterator's current position. It is only
+// valid after a call to [Iterator.Next] that returned true.
+func (it *Iterator) Key() string {
+	if it.i == 0 || it.i > len(it.keys) {
+		return ""
+	}
+	return it.keys[it.i-1]
+}
+
+// Err returns the first error, if any, encountered while iterating.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Cursor returns a cursor that can be stored in [ListOptions.Cursor] to
+// resume this listing, for example from a later request. It always
+// resumes at or before the earliest key not yet seen via [Iterator.Key]
+// — it may redeliver a few keys from the current page, but never skips
+// one.
+func (it *Iterator) Cursor() string {
+	return it.cursor
+}
+
+// Keys lists and returns every key in the store matching prefix,
+// exhausting the underlying iterator.
+func (s *Store) Keys(prefix string) ([]string, error) {
+	it := s.List(&ListOptions{Prefix: prefix})
+
+	var keys []string
+	for it.Next() {
+		keys = append(keys, it.Key())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
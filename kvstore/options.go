@@ -0,0 +1,103 @@
+package kvstore
+
+import (
+	"time"
+
+	"github.com/fastly/compute-sdk-go/internal/abi/fastly"
+)
+
+// InsertMode selects how [Store.Insert] treats an existing value for the
+// same key. The zero value is [ModeOverwrite].
+type InsertMode int
+
+const (
+	// ModeOverwrite creates the key if it doesn't exist, and replaces
+	// its value if it does. This is the default.
+	ModeOverwrite InsertMode = iota
+
+	// ModeAdd creates the key only if it doesn't already exist. If the
+	// key exists, Insert fails with [ErrKeyExists].
+	ModeAdd
+
+	// ModeAppend appends the inserted value to the key's existing
+	// value.
+	ModeAppend
+
+	// ModePrepend prepends the inserted value to the key's existing
+	// value.
+	ModePrepend
+)
+
+// insertConfig collects the options passed to [Store.Insert].
+type insertConfig struct {
+	mode              InsertMode
+	ifGenerationMatch uint64
+	hasGenMatch       bool
+	ttl               time.Duration
+	metadata          []byte
+}
+
+func (c insertConfig) abi() *fastly.KVStoreInsertConfig {
+	return &fastly.KVStoreInsertConfig{
+		Mode:               c.mode.abi(),
+		Metadata:           c.metadata,
+		TimeToLive:         c.ttl,
+		IfGenerationMatch:  c.ifGenerationMatch,
+		GenerationMatchSet: c.hasGenMatch,
+	}
+}
+
+// abi maps m to its corresponding host-side mode. This is an explicit
+// switch, rather than a numeric cast, so the two enums can evolve
+// independently.
+func (m InsertMode) abi() fastly.KVStoreInsertMode {
+	switch m {
+	case ModeAdd:
+		return fastly.KVStoreInsertModeAdd
+	case ModeAppend:
+		return fastly.KVStoreInsertModeAppend
+	case ModePrepend:
+		return fastly.KVStoreInsertModePrepend
+	case ModeOverwrite:
+		return fastly.KVStoreInsertModeOverwrite
+	default:
+		return fastly.KVStoreInsertModeOverwrite
+	}
+}
+
+// InsertOption configures a call to [Store.Insert].
+type InsertOption func(*insertConfig)
+
+// Mode sets the [InsertMode] used by [Store.Insert].
+func Mode(m InsertMode) InsertOption {
+	return func(c *insertConfig) {
+		c.mode = m
+	}
+}
+
+// IfGenerationMatch makes [Store.Insert] conditional on the key's current
+// generation matching gen, as previously observed via [Entry.Generation].
+// If the generation doesn't match, Insert fails with
+// [ErrPreconditionFailed].
+func IfGenerationMatch(gen uint64) InsertOption {
+	return func(c *insertConfig) {
+		c.ifGenerationMatch = gen
+		c.hasGenMatch = true
+	}
+}
+
+// TTL sets how long the inserted value should live before the store
+// expires it.
+func TTL(d time.Duration) InsertOption {
+	return func(c *insertConfig) {
+		c.ttl = d
+	}
+}
+
+// Metadata attaches opaque metadata to the inserted value, retrievable
+// later via [Entry.Metadata].
+func Metadata(b []byte) InsertOption {
+	return func(c *insertConfig) {
+		c.metadata = b
+	}
+}
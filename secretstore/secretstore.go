@@ -0,0 +1,121 @@
+// Package secretstore provides access to Fastly secret stores.
+//
+// Secret stores hold values that are encrypted at rest and only
+// decrypted on demand at the edge, for data such as API keys and
+// credentials that shouldn't be handled as plain config.
+//
+// See the [Fastly secret store documentation] for details.
+//
+// [Fastly secret store documentation]: https://developer.fastly.com/learning/concepts/data-stores/#secret-stores
+package secretstore
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/fastly/compute-sdk-go/internal/abi/fastly"
+)
+
+var (
+	// ErrStoreNotFound indicates that the named secret store doesn't exist.
+	ErrStoreNotFound = errors.New("secretstore: store not found")
+
+	// ErrSecretNotFound indicates that the named secret doesn't exist in
+	// this secret store.
+	ErrSecretNotFound = errors.New("secretstore: secret not found")
+
+	// ErrInvalidKey indicates that the given key is invalid.
+	ErrInvalidKey = errors.New("secretstore: invalid key")
+
+	// ErrUnexpected indicates than an unexpected error occurred.
+	ErrUnexpected = errors.New("secretstore: unexpected error")
+)
+
+// Store represents a Fastly secret store.
+type Store struct {
+	store *fastly.SecretStore
+}
+
+// Open returns a handle to the named secret store.
+func Open(name string) (*Store, error) {
+	o, err := fastly.OpenSecretStore(name)
+	if err != nil {
+		status, ok := fastly.IsFastlyError(err)
+		switch {
+		case ok && status == fastly.FastlyStatusNone:
+			return nil, ErrStoreNotFound
+		case ok:
+			return nil, fmt.Errorf("%w (%s)", ErrUnexpected, status)
+		default:
+			return nil, err
+		}
+	}
+
+	return &Store{store: o}, nil
+}
+
+// Get fetches the secret with the given key from the associated secret
+// store. If the key does not exist, Get returns the sentinel error
+// [ErrSecretNotFound].
+//
+// Get only returns a handle to the secret; the plaintext isn't decrypted
+// until [Secret.Plaintext] or [Secret.PlaintextReader] is called.
+func (s *Store) Get(key string) (*Secret, error) {
+	sec, err := s.store.Get(key)
+	if err != nil {
+		status, ok := fastly.IsFastlyError(err)
+		switch {
+		case ok && status == fastly.FastlyStatusNone:
+			return nil, ErrSecretNotFound
+		case ok && status == fastly.FastlyStatusInval:
+			return nil, ErrInvalidKey
+		case ok:
+			return nil, fmt.Errorf("%w (%s)", ErrUnexpected, status)
+		default:
+			return nil, err
+		}
+	}
+
+	return &Secret{secret: sec}, nil
+}
+
+// Secret is an opaque handle to a value held in a secret store.
+//
+// The handle can be passed around and stored without decrypting the
+// underlying value; the plaintext is only fetched when
+// [Secret.Plaintext] or [Secret.PlaintextReader] is called, and both may
+// be called more than once.
+type Secret struct {
+	secret *fastly.Secret
+}
+
+// Plaintext decrypts and returns the contents of the secret.
+//
+// For values that may be too large to comfortably hold as a []byte, use
+// [Secret.PlaintextReader] instead.
+func (s *Secret) Plaintext() ([]byte, error) {
+	r, err := s.PlaintextReader()
+	if err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(r)
+}
+
+// PlaintextReader decrypts the secret and returns an [io.Reader] over its
+// contents.
+func (s *Secret) PlaintextReader() (io.Reader, error) {
+	val, err := s.secret.Plaintext()
+	if err != nil {
+		status, ok := fastly.IsFastlyError(err)
+		switch {
+		case ok:
+			return nil, fmt.Errorf("%w (%s)", ErrUnexpected, status)
+		default:
+			return nil, err
+		}
+	}
+
+	return val, nil
+}